@@ -0,0 +1,52 @@
+// Package adapter defines the storage-facing interface used by resolvers.
+package adapter
+
+import (
+	"errors"
+
+	"goask/core/entity"
+)
+
+// ErrNotFound is returned by lookup and mutation methods when the
+// requested entity does not exist.
+var ErrNotFound = errors.New("adapter: not found")
+
+// Data is the storage access surface used by the resolver package.
+type Data interface {
+	// AnswersOfQuestion returns every answer belonging to any of
+	// questionIDs, so that a batch of questions can be resolved with a
+	// single round-trip instead of one query per question.
+	AnswersOfQuestion(questionIDs []int) []entity.Answer
+	QuestionByID(id int) (entity.Question, error)
+	AnswerByID(id int) (entity.Answer, error)
+	UserByID(id int) (entity.User, error)
+
+	InsertQuestion(title, content string, authorID int) (entity.Question, error)
+	InsertAnswer(questionID int, content string, authorID int) (entity.Answer, error)
+	// UpdateQuestion applies a partial update: a nil field is left
+	// unchanged. It returns ErrNotFound if id does not exist.
+	UpdateQuestion(id int, title, content *string) (entity.Question, error)
+	// DeleteQuestion returns ErrNotFound if id does not exist.
+	DeleteQuestion(id int) error
+
+	// QuestionsPage returns up to limit questions matching filter that
+	// sort after cursor (as produced by EncodeCursor), ordered by
+	// (created_at, id) so pages stay stable across concurrent inserts.
+	// Filtering and paging are both pushed down to storage rather than
+	// applied by slicing an in-memory list.
+	QuestionsPage(filter QuestionFilter, cursor string, limit int) (QuestionPage, error)
+}
+
+// QuestionFilter narrows QuestionsPage results. Zero-value fields are not
+// applied.
+type QuestionFilter struct {
+	TitleContains string
+	AuthorID      *int
+	HasAnswers    *bool
+}
+
+// QuestionPage is one page of a QuestionsPage result.
+type QuestionPage struct {
+	Questions   []entity.Question
+	HasNextPage bool
+}
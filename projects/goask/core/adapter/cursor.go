@@ -0,0 +1,40 @@
+package adapter
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+// cursor is the decoded form of an opaque pagination cursor. Ordering
+// results on (created_at, id) and encoding both into the cursor keeps
+// pages stable even as rows are inserted between requests.
+type cursor struct {
+	ID        int       `json:"id"`
+	CreatedAt time.Time `json:"createdAt"`
+}
+
+// EncodeCursor returns the opaque cursor for a row with the given id and
+// createdAt.
+func EncodeCursor(id int, createdAt time.Time) string {
+	data, _ := json.Marshal(cursor{ID: id, CreatedAt: createdAt})
+	return base64.URLEncoding.EncodeToString(data)
+}
+
+// DecodeCursor reverses EncodeCursor. An empty string decodes to the zero
+// cursor, meaning "start from the beginning".
+func DecodeCursor(s string) (id int, createdAt time.Time, err error) {
+	if s == "" {
+		return 0, time.Time{}, nil
+	}
+	data, err := base64.URLEncoding.DecodeString(s)
+	if err != nil {
+		return 0, time.Time{}, fmt.Errorf("adapter: invalid cursor: %w", err)
+	}
+	var c cursor
+	if err := json.Unmarshal(data, &c); err != nil {
+		return 0, time.Time{}, fmt.Errorf("adapter: invalid cursor: %w", err)
+	}
+	return c.ID, c.CreatedAt, nil
+}
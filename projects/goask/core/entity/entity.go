@@ -0,0 +1,27 @@
+// Package entity holds the plain data types shared by storage and resolvers.
+package entity
+
+import "time"
+
+// Question is a question asked by a user.
+type Question struct {
+	ID        int
+	Title     string
+	Content   string
+	AuthorID  int
+	CreatedAt time.Time
+}
+
+// Answer is an answer to a Question.
+type Answer struct {
+	ID         int
+	Content    string
+	QuestionID int
+	AuthorID   int
+}
+
+// User is a registered user that can ask questions and post answers.
+type User struct {
+	ID   int
+	Name string
+}
@@ -0,0 +1,36 @@
+package resolver
+
+import (
+	"context"
+
+	"goask/resolver/directive"
+)
+
+// NewDirectiveRegistry builds the directive.Registry used to enforce
+// schema directives during field resolution. Mutation fields and
+// restricted queries apply the "auth" directive, matching @auth(role:
+// Role) in the schema.
+func NewDirectiveRegistry() *directive.Registry {
+	registry := directive.NewRegistry()
+	registry.Register("auth", func(ctx context.Context, args map[string]interface{}, next directive.Next) (interface{}, error) {
+		caller, err := CallerFromContext(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if role, _ := args["role"].(string); role != "" && string(caller.Role) != role {
+			return nil, newFieldError("caller lacks required role "+role, "role", "FORBIDDEN")
+		}
+		return next(ctx)
+	})
+	return registry
+}
+
+// requireRole runs the "auth" directive for role, returning its error (if
+// any) without a meaningful result value. It's the call every @auth(role:
+// Role)-decorated field makes before doing its own work.
+func requireRole(ctx context.Context, directives *directive.Registry, role Role) error {
+	_, err := directives.Apply(ctx, "auth", map[string]interface{}{"role": string(role)}, func(ctx context.Context) (interface{}, error) {
+		return nil, nil
+	})
+	return err
+}
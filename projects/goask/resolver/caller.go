@@ -0,0 +1,44 @@
+package resolver
+
+import (
+	"context"
+	"errors"
+)
+
+// Role identifies a caller's privilege level, as checked by the @auth(role:
+// Role) directive.
+type Role string
+
+const (
+	RoleUser  Role = "USER"
+	RoleAdmin Role = "ADMIN"
+)
+
+// Caller is the identity of whoever issued the current request, as
+// established by the HTTP-layer auth middleware.
+type Caller struct {
+	UserID int
+	Role   Role
+}
+
+type callerContextKey struct{}
+
+// ErrNoCaller is returned by CallerFromContext when the request carries
+// no authenticated caller.
+var ErrNoCaller = errors.New("resolver: no authenticated caller in context")
+
+// WithCaller returns a copy of ctx carrying caller, retrievable via
+// CallerFromContext.
+func WithCaller(ctx context.Context, caller Caller) context.Context {
+	return context.WithValue(ctx, callerContextKey{}, caller)
+}
+
+// CallerFromContext returns the authenticated caller attached to ctx, or
+// ErrNoCaller if the request was not authenticated.
+func CallerFromContext(ctx context.Context) (Caller, error) {
+	caller, ok := ctx.Value(callerContextKey{}).(Caller)
+	if !ok {
+		return Caller{}, ErrNoCaller
+	}
+	return caller, nil
+}
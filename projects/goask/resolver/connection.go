@@ -0,0 +1,127 @@
+package resolver
+
+import (
+	"time"
+
+	"goask/core/adapter"
+	"goask/core/entity"
+)
+
+// PageInfo is the Relay pagination metadata attached to a connection.
+type PageInfo struct {
+	hasNextPage bool
+	endCursor   string
+}
+
+func (p PageInfo) HasNextPage() bool {
+	return p.hasNextPage
+}
+
+func (p PageInfo) EndCursor() *string {
+	if p.endCursor == "" {
+		return nil
+	}
+	return &p.endCursor
+}
+
+// QuestionEdge pairs a Question with the cursor pointing at it.
+type QuestionEdge struct {
+	node   Question
+	cursor string
+}
+
+func (e QuestionEdge) Node() Question {
+	return e.node
+}
+
+func (e QuestionEdge) Cursor() string {
+	return e.cursor
+}
+
+// QuestionConnection is the Relay connection type returned by the
+// paginated Questions root query.
+type QuestionConnection struct {
+	edges    []QuestionEdge
+	pageInfo PageInfo
+}
+
+func (c QuestionConnection) Edges() []QuestionEdge {
+	return c.edges
+}
+
+func (c QuestionConnection) PageInfo() PageInfo {
+	return c.pageInfo
+}
+
+// newQuestionConnection builds a QuestionConnection from a storage page,
+// encoding each question's cursor from its (id, createdAt).
+func newQuestionConnection(page adapter.QuestionPage, data adapter.Data) QuestionConnection {
+	edges := make([]QuestionEdge, len(page.Questions))
+	for i, question := range page.Questions {
+		edges[i] = QuestionEdge{
+			node:   QuestionOne(question, data),
+			cursor: adapter.EncodeCursor(question.ID, question.CreatedAt),
+		}
+	}
+	var endCursor string
+	if len(edges) > 0 {
+		endCursor = edges[len(edges)-1].cursor
+	}
+	return QuestionConnection{
+		edges: edges,
+		pageInfo: PageInfo{
+			hasNextPage: page.HasNextPage,
+			endCursor:   endCursor,
+		},
+	}
+}
+
+// AnswerEdge pairs an Answer with the cursor pointing at it.
+type AnswerEdge struct {
+	node   Answer
+	cursor string
+}
+
+func (e AnswerEdge) Node() Answer {
+	return e.node
+}
+
+func (e AnswerEdge) Cursor() string {
+	return e.cursor
+}
+
+// AnswerConnection is the Relay connection type for a paginated list of
+// answers, mirroring QuestionConnection.
+type AnswerConnection struct {
+	edges    []AnswerEdge
+	pageInfo PageInfo
+}
+
+func (c AnswerConnection) Edges() []AnswerEdge {
+	return c.edges
+}
+
+func (c AnswerConnection) PageInfo() PageInfo {
+	return c.pageInfo
+}
+
+// newAnswerConnection builds an AnswerConnection from a question's full
+// answer list. Answers aren't paged against storage yet, so the
+// connection always reports a single, complete page.
+func newAnswerConnection(answers []entity.Answer, data adapter.Data) AnswerConnection {
+	edges := make([]AnswerEdge, len(answers))
+	for i, answer := range answers {
+		edges[i] = AnswerEdge{
+			node:   AnswerOne(answer, data),
+			cursor: adapter.EncodeCursor(answer.ID, time.Time{}),
+		}
+	}
+	var endCursor string
+	if len(edges) > 0 {
+		endCursor = edges[len(edges)-1].cursor
+	}
+	return AnswerConnection{
+		edges:    edges,
+		pageInfo: PageInfo{endCursor: endCursor},
+	}
+}
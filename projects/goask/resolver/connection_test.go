@@ -0,0 +1,148 @@
+package resolver
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+func mustCreateQuestion(t *testing.T, data *fakeData, ctx context.Context, title string, createdAt time.Time) Question {
+	t.Helper()
+	q, err := NewMutation(data).CreateQuestion(ctx, struct{ Input CreateQuestionInput }{
+		Input: CreateQuestionInput{Title: title, Content: "content"},
+	})
+	if err != nil {
+		t.Fatalf("CreateQuestion(%q): %v", title, err)
+	}
+	data.setCreatedAt(int(q.ID()), createdAt)
+	return q
+}
+
+func TestQuestionsForwardPagination(t *testing.T) {
+	data := &fakeData{}
+	ctx := WithCaller(context.Background(), Caller{UserID: 1, Role: RoleUser})
+	base := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	q1 := mustCreateQuestion(t, data, ctx, "Q1", base)
+	q2 := mustCreateQuestion(t, data, ctx, "Q2", base.Add(time.Minute))
+	q3 := mustCreateQuestion(t, data, ctx, "Q3", base.Add(2*time.Minute))
+
+	root := NewRoot(data)
+	first := int32(2)
+
+	page1, err := root.Questions(ctx, struct {
+		First  *int32
+		After  *string
+		Filter *QuestionsFilterInput
+	}{First: &first})
+	if err != nil {
+		t.Fatalf("Questions (page1): %v", err)
+	}
+	if len(page1.Edges()) != 2 {
+		t.Fatalf("page1 edges = %d, want 2", len(page1.Edges()))
+	}
+	if page1.Edges()[0].Node().ID() != q1.ID() || page1.Edges()[1].Node().ID() != q2.ID() {
+		t.Fatalf("page1 = %v, want [Q1, Q2]", page1.Edges())
+	}
+	if !page1.PageInfo().HasNextPage() {
+		t.Fatal("page1.PageInfo().HasNextPage() = false, want true")
+	}
+
+	after := *page1.PageInfo().EndCursor()
+	page2, err := root.Questions(ctx, struct {
+		First  *int32
+		After  *string
+		Filter *QuestionsFilterInput
+	}{First: &first, After: &after})
+	if err != nil {
+		t.Fatalf("Questions (page2): %v", err)
+	}
+	if len(page2.Edges()) != 1 || page2.Edges()[0].Node().ID() != q3.ID() {
+		t.Fatalf("page2 = %v, want [Q3]", page2.Edges())
+	}
+	if page2.PageInfo().HasNextPage() {
+		t.Fatal("page2.PageInfo().HasNextPage() = true, want false")
+	}
+}
+
+func TestQuestionsStableCursorAcrossInserts(t *testing.T) {
+	data := &fakeData{}
+	ctx := WithCaller(context.Background(), Caller{UserID: 1, Role: RoleUser})
+	base := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	mustCreateQuestion(t, data, ctx, "Q1", base)
+	q2 := mustCreateQuestion(t, data, ctx, "Q2", base.Add(time.Minute))
+	q3 := mustCreateQuestion(t, data, ctx, "Q3", base.Add(2*time.Minute))
+
+	root := NewRoot(data)
+	first := int32(2)
+	page1, err := root.Questions(ctx, struct {
+		First  *int32
+		After  *string
+		Filter *QuestionsFilterInput
+	}{First: &first})
+	if err != nil {
+		t.Fatalf("Questions (page1): %v", err)
+	}
+	cursor := *page1.PageInfo().EndCursor()
+
+	// A concurrent insert that sorts before the cursor must not shift or
+	// duplicate the next page.
+	mustCreateQuestion(t, data, ctx, "Q0.5", base.Add(30*time.Second))
+
+	page2, err := root.Questions(ctx, struct {
+		First  *int32
+		After  *string
+		Filter *QuestionsFilterInput
+	}{First: &first, After: &cursor})
+	if err != nil {
+		t.Fatalf("Questions (page2): %v", err)
+	}
+	if len(page2.Edges()) != 1 || page2.Edges()[0].Node().ID() != q3.ID() {
+		t.Fatalf("page2 = %v, want [Q3]", page2.Edges())
+	}
+	_ = q2
+}
+
+func TestQuestionsEmptyPage(t *testing.T) {
+	root := NewRoot(&fakeData{})
+	first := int32(10)
+
+	page, err := root.Questions(context.Background(), struct {
+		First  *int32
+		After  *string
+		Filter *QuestionsFilterInput
+	}{First: &first})
+	if err != nil {
+		t.Fatalf("Questions: %v", err)
+	}
+	if len(page.Edges()) != 0 {
+		t.Fatalf("Edges() = %v, want empty", page.Edges())
+	}
+	if page.PageInfo().HasNextPage() {
+		t.Fatal("PageInfo().HasNextPage() = true, want false")
+	}
+	if page.PageInfo().EndCursor() != nil {
+		t.Fatalf("PageInfo().EndCursor() = %v, want nil", page.PageInfo().EndCursor())
+	}
+}
+
+func TestQuestionsRejectsNonPositiveFirst(t *testing.T) {
+	root := NewRoot(&fakeData{})
+
+	for _, first := range []int32{0, -1} {
+		first := first
+		_, err := root.Questions(context.Background(), struct {
+			First  *int32
+			After  *string
+			Filter *QuestionsFilterInput
+		}{First: &first})
+		fieldErr, ok := err.(*FieldError)
+		if !ok {
+			t.Fatalf("Questions(first=%d) error = %v, want *FieldError", first, err)
+		}
+		if fieldErr.Field != "first" {
+			t.Errorf("Questions(first=%d) FieldError.Field = %q, want %q", first, fieldErr.Field, "first")
+		}
+	}
+}
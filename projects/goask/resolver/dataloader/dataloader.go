@@ -0,0 +1,138 @@
+// Package dataloader batches and deduplicates per-request lookups to avoid
+// N+1 round-trips when a GraphQL query resolves a list of nodes.
+package dataloader
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// batchWindow is how long a Loader waits for more keys to arrive before
+// dispatching a batch.
+const batchWindow = time.Millisecond
+
+// maxBatchSize caps how many keys are sent to a single BatchFn call.
+const maxBatchSize = 1000
+
+// BatchFn loads a batch of keys, returning one value (or error) per key in
+// the same order as keys.
+type BatchFn[K comparable, V any] func(keys []K) ([]V, []error)
+
+// Loader batches and caches loads for a single request.
+type Loader[K comparable, V any] struct {
+	batchFn BatchFn[K, V]
+
+	mu      sync.Mutex
+	cache   map[K]*result[V]
+	pending []pendingKey[K, V]
+	timer   *time.Timer
+}
+
+type result[V any] struct {
+	value V
+	err   error
+	done  chan struct{}
+}
+
+type pendingKey[K comparable, V any] struct {
+	key K
+	res *result[V]
+}
+
+// New creates a Loader backed by batchFn. A Loader is meant to live for the
+// lifetime of a single request and must not be shared across requests.
+func New[K comparable, V any](batchFn BatchFn[K, V]) *Loader[K, V] {
+	return &Loader[K, V]{
+		batchFn: batchFn,
+		cache:   make(map[K]*result[V]),
+	}
+}
+
+// Load returns the value for key, batching this call with any other Load
+// or LoadMany calls made within the loader's batch window.
+func (l *Loader[K, V]) Load(ctx context.Context, key K) (V, error) {
+	return l.wait(ctx, l.enqueue(key))
+}
+
+// LoadMany loads a slice of keys, preserving their order in the result.
+// All keys are enqueued before any wait happens, so a single caller
+// resolving many keys still produces one batch instead of one per key.
+func (l *Loader[K, V]) LoadMany(ctx context.Context, keys []K) ([]V, []error) {
+	results := make([]*result[V], len(keys))
+	for i, key := range keys {
+		results[i] = l.enqueue(key)
+	}
+
+	values := make([]V, len(keys))
+	errs := make([]error, len(keys))
+	for i, r := range results {
+		values[i], errs[i] = l.wait(ctx, r)
+	}
+	return values, errs
+}
+
+// enqueue registers key for the next batch (or returns its in-flight/cached
+// result) without waiting for it to resolve.
+func (l *Loader[K, V]) enqueue(key K) *result[V] {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if r, ok := l.cache[key]; ok {
+		return r
+	}
+
+	r := &result[V]{done: make(chan struct{})}
+	l.cache[key] = r
+	l.pending = append(l.pending, pendingKey[K, V]{key: key, res: r})
+
+	if len(l.pending) >= maxBatchSize {
+		l.dispatchLocked()
+	} else if l.timer == nil {
+		l.timer = time.AfterFunc(batchWindow, l.dispatch)
+	}
+	return r
+}
+
+func (l *Loader[K, V]) wait(ctx context.Context, r *result[V]) (V, error) {
+	select {
+	case <-r.done:
+		return r.value, r.err
+	case <-ctx.Done():
+		var zero V
+		return zero, ctx.Err()
+	}
+}
+
+func (l *Loader[K, V]) dispatch() {
+	l.mu.Lock()
+	l.dispatchLocked()
+	l.mu.Unlock()
+}
+
+// dispatchLocked must be called with l.mu held.
+func (l *Loader[K, V]) dispatchLocked() {
+	if l.timer != nil {
+		l.timer.Stop()
+		l.timer = nil
+	}
+	batch := l.pending
+	l.pending = nil
+	if len(batch) == 0 {
+		return
+	}
+
+	keys := make([]K, len(batch))
+	for i, pk := range batch {
+		keys[i] = pk.key
+	}
+
+	values, errs := l.batchFn(keys)
+	for i, pk := range batch {
+		pk.res.value = values[i]
+		if errs != nil {
+			pk.res.err = errs[i]
+		}
+		close(pk.res.done)
+	}
+}
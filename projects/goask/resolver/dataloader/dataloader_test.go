@@ -0,0 +1,93 @@
+package dataloader
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"testing"
+)
+
+func TestLoaderBatchesConcurrentLoads(t *testing.T) {
+	var calls int32
+	loader := New(func(keys []int) ([]int, []error) {
+		atomic.AddInt32(&calls, 1)
+		values := make([]int, len(keys))
+		for i, k := range keys {
+			values[i] = k * 2
+		}
+		return values, nil
+	})
+
+	ctx := context.Background()
+	var wg sync.WaitGroup
+	results := make([]int, 5)
+	for i := 0; i < 5; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			v, err := loader.Load(ctx, i)
+			if err != nil {
+				t.Errorf("Load(%d): %v", i, err)
+			}
+			results[i] = v
+		}(i)
+	}
+	wg.Wait()
+
+	if got := atomic.LoadInt32(&calls); got != 1 {
+		t.Fatalf("batchFn called %d times, want 1", got)
+	}
+	for i, v := range results {
+		if v != i*2 {
+			t.Errorf("results[%d] = %d, want %d", i, v, i*2)
+		}
+	}
+}
+
+func TestLoaderLoadManyBatchesInOneCall(t *testing.T) {
+	var calls int32
+	loader := New(func(keys []int) ([]int, []error) {
+		atomic.AddInt32(&calls, 1)
+		values := make([]int, len(keys))
+		for i, k := range keys {
+			values[i] = k * 2
+		}
+		return values, nil
+	})
+
+	values, errs := loader.LoadMany(context.Background(), []int{0, 1, 2, 3, 4})
+	for i, err := range errs {
+		if err != nil {
+			t.Errorf("LoadMany key %d: %v", i, err)
+		}
+	}
+	for i, v := range values {
+		if v != i*2 {
+			t.Errorf("values[%d] = %d, want %d", i, v, i*2)
+		}
+	}
+
+	if got := atomic.LoadInt32(&calls); got != 1 {
+		t.Fatalf("batchFn called %d times, want 1", got)
+	}
+}
+
+func TestLoaderCachesWithinRequest(t *testing.T) {
+	var calls int32
+	loader := New(func(keys []int) ([]int, []error) {
+		atomic.AddInt32(&calls, 1)
+		return []int{keys[0]}, nil
+	})
+
+	ctx := context.Background()
+	if _, err := loader.Load(ctx, 1); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := loader.Load(ctx, 1); err != nil {
+		t.Fatal(err)
+	}
+
+	if got := atomic.LoadInt32(&calls); got != 1 {
+		t.Fatalf("batchFn called %d times, want 1", got)
+	}
+}
@@ -0,0 +1,78 @@
+package dataloader
+
+import (
+	"context"
+	"net/http"
+
+	"goask/core/adapter"
+	"goask/core/entity"
+)
+
+// Loaders bundles the set of loaders attached to a single request.
+type Loaders struct {
+	AnswersByQuestionID *Loader[int, []entity.Answer]
+	QuestionByID        *Loader[int, entity.Question]
+	UserByID            *Loader[int, entity.User]
+}
+
+type contextKey struct{}
+
+// NewLoaders builds a fresh Loaders set backed by data. A new set must be created
+// per request so that batching and caching never leak across requests.
+func NewLoaders(data adapter.Data) *Loaders {
+	return &Loaders{
+		AnswersByQuestionID: New(func(keys []int) ([][]entity.Answer, []error) {
+			byQuestion := make(map[int][]entity.Answer, len(keys))
+			for _, answer := range data.AnswersOfQuestion(keys) {
+				byQuestion[answer.QuestionID] = append(byQuestion[answer.QuestionID], answer)
+			}
+			values := make([][]entity.Answer, len(keys))
+			for i, questionID := range keys {
+				values[i] = byQuestion[questionID]
+			}
+			return values, nil
+		}),
+		QuestionByID: New(func(keys []int) ([]entity.Question, []error) {
+			values := make([]entity.Question, len(keys))
+			errs := make([]error, len(keys))
+			for i, id := range keys {
+				values[i], errs[i] = data.QuestionByID(id)
+			}
+			return values, errs
+		}),
+		UserByID: New(func(keys []int) ([]entity.User, []error) {
+			values := make([]entity.User, len(keys))
+			errs := make([]error, len(keys))
+			for i, id := range keys {
+				values[i], errs[i] = data.UserByID(id)
+			}
+			return values, errs
+		}),
+	}
+}
+
+// WithLoaders returns a copy of ctx carrying loaders, retrievable via
+// FromContext.
+func WithLoaders(ctx context.Context, loaders *Loaders) context.Context {
+	return context.WithValue(ctx, contextKey{}, loaders)
+}
+
+// Middleware attaches a fresh Loaders set to each incoming request's context.
+func Middleware(data adapter.Data) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			ctx := WithLoaders(r.Context(), NewLoaders(data))
+			next.ServeHTTP(w, r.WithContext(ctx))
+		})
+	}
+}
+
+// FromContext returns the Loaders set attached to ctx by Middleware. It
+// panics if ctx has none, since that indicates Middleware was not wired up.
+func FromContext(ctx context.Context) *Loaders {
+	loaders, ok := ctx.Value(contextKey{}).(*Loaders)
+	if !ok {
+		panic("dataloader: no Loaders in context; is Middleware installed?")
+	}
+	return loaders
+}
@@ -0,0 +1,41 @@
+// Package directive lets resolvers apply schema directives (such as
+// @auth(role: Role)) around field resolution without graphql-go codegen
+// support for directives.
+package directive
+
+import "context"
+
+// Next resolves the field a directive decorates.
+type Next func(ctx context.Context) (interface{}, error)
+
+// Func implements one directive. args holds the directive's arguments as
+// given in the schema (e.g. {"role": "ADMIN"} for @auth(role: ADMIN)). A
+// Func must call next to continue resolution, or return early (typically
+// with an error) to short-circuit it.
+type Func func(ctx context.Context, args map[string]interface{}, next Next) (interface{}, error)
+
+// Registry maps directive name to its implementation.
+type Registry struct {
+	directives map[string]Func
+}
+
+// NewRegistry returns an empty Registry.
+func NewRegistry() *Registry {
+	return &Registry{directives: make(map[string]Func)}
+}
+
+// Register adds the implementation for a directive name, overwriting any
+// previously registered under it.
+func (r *Registry) Register(name string, fn Func) {
+	r.directives[name] = fn
+}
+
+// Apply invokes the named directive around next. If no directive is
+// registered under name, next runs unwrapped.
+func (r *Registry) Apply(ctx context.Context, name string, args map[string]interface{}, next Next) (interface{}, error) {
+	fn, ok := r.directives[name]
+	if !ok {
+		return next(ctx)
+	}
+	return fn(ctx, args, next)
+}
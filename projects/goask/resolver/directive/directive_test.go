@@ -0,0 +1,41 @@
+package directive
+
+import (
+	"context"
+	"errors"
+	"testing"
+)
+
+func TestApplyRunsRegisteredDirective(t *testing.T) {
+	registry := NewRegistry()
+	registry.Register("auth", func(ctx context.Context, args map[string]interface{}, next Next) (interface{}, error) {
+		if args["role"] != "ADMIN" {
+			return nil, errors.New("forbidden")
+		}
+		return next(ctx)
+	})
+
+	_, err := registry.Apply(context.Background(), "auth", map[string]interface{}{"role": "USER"}, func(ctx context.Context) (interface{}, error) {
+		return "ok", nil
+	})
+	if err == nil {
+		t.Fatal("Apply: expected error for wrong role, got nil")
+	}
+
+	got, err := registry.Apply(context.Background(), "auth", map[string]interface{}{"role": "ADMIN"}, func(ctx context.Context) (interface{}, error) {
+		return "ok", nil
+	})
+	if err != nil || got != "ok" {
+		t.Fatalf("Apply() = (%v, %v), want (ok, nil)", got, err)
+	}
+}
+
+func TestApplyPassesThroughUnregisteredDirective(t *testing.T) {
+	registry := NewRegistry()
+	got, err := registry.Apply(context.Background(), "unknown", nil, func(ctx context.Context) (interface{}, error) {
+		return "ok", nil
+	})
+	if err != nil || got != "ok" {
+		t.Fatalf("Apply() = (%v, %v), want (ok, nil)", got, err)
+	}
+}
@@ -0,0 +1,41 @@
+package resolver
+
+import (
+	"errors"
+
+	"goask/core/adapter"
+)
+
+// FieldError is a validation or domain error surfaced to GraphQL clients
+// with structured extensions. graphql-go merges the map returned by any
+// error implementing `Extensions() map[string]interface{}` into the
+// response's `errors[].extensions`.
+type FieldError struct {
+	msg   string
+	Field string
+	Code  string
+}
+
+func newFieldError(msg, field, code string) *FieldError {
+	return &FieldError{msg: msg, Field: field, Code: code}
+}
+
+func (e *FieldError) Error() string {
+	return e.msg
+}
+
+func (e *FieldError) Extensions() map[string]interface{} {
+	return map[string]interface{}{
+		"code":  e.Code,
+		"field": e.Field,
+	}
+}
+
+// toFieldError translates known adapter errors into a FieldError with
+// client-actionable extensions, passing through anything else unchanged.
+func toFieldError(field string, err error) error {
+	if errors.Is(err, adapter.ErrNotFound) {
+		return newFieldError(err.Error(), field, "NOT_FOUND")
+	}
+	return err
+}
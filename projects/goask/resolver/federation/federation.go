@@ -0,0 +1,78 @@
+// Package federation implements the subset of the Apollo Federation spec
+// that a subgraph needs to join a supergraph: the `_Any` scalar and entity
+// resolution by `__typename`.
+package federation
+
+import (
+	"context"
+	"fmt"
+)
+
+// Any is the federation `_Any` scalar: an opaque representation map that
+// always carries `__typename` plus whatever `@key` fields identify the
+// entity.
+type Any map[string]interface{}
+
+// ImplementsGraphQLType marks Any as the `_Any` custom scalar.
+func (Any) ImplementsGraphQLType(name string) bool {
+	return name == "_Any"
+}
+
+// UnmarshalGraphQL decodes a `_Any` scalar from the object literal
+// graphql-go hands us for input of this shape.
+func (a *Any) UnmarshalGraphQL(input interface{}) error {
+	m, ok := input.(map[string]interface{})
+	if !ok {
+		return fmt.Errorf("federation: _Any must be an object, got %T", input)
+	}
+	*a = m
+	return nil
+}
+
+// Typename returns the `__typename` field of a representation, or "" if
+// absent.
+func (a Any) Typename() string {
+	name, _ := a["__typename"].(string)
+	return name
+}
+
+// ReferenceResolver builds the entity identified by a single
+// representation.
+type ReferenceResolver func(ctx context.Context, ref Any) (interface{}, error)
+
+// Registry maps a GraphQL typename to the reference resolver that can
+// build that type from an `_Any` representation, as required by
+// `_entities`.
+type Registry struct {
+	resolvers map[string]ReferenceResolver
+}
+
+// NewRegistry returns an empty Registry.
+func NewRegistry() *Registry {
+	return &Registry{resolvers: make(map[string]ReferenceResolver)}
+}
+
+// Register adds the reference resolver for typename, overwriting any
+// resolver previously registered for it.
+func (r *Registry) Register(typename string, resolve ReferenceResolver) {
+	r.resolvers[typename] = resolve
+}
+
+// Resolve builds the entities named by representations, in order, as
+// `_entities` requires. A representation naming an unregistered typename
+// is an error.
+func (r *Registry) Resolve(ctx context.Context, representations []Any) ([]interface{}, error) {
+	entities := make([]interface{}, len(representations))
+	for i, ref := range representations {
+		resolve, ok := r.resolvers[ref.Typename()]
+		if !ok {
+			return nil, fmt.Errorf("federation: no reference resolver registered for typename %q", ref.Typename())
+		}
+		entity, err := resolve(ctx, ref)
+		if err != nil {
+			return nil, err
+		}
+		entities[i] = entity
+	}
+	return entities, nil
+}
@@ -0,0 +1,39 @@
+package federation
+
+import (
+	"context"
+	"testing"
+)
+
+func TestRegistryResolveDispatchesByTypename(t *testing.T) {
+	registry := NewRegistry()
+	registry.Register("Question", func(ctx context.Context, ref Any) (interface{}, error) {
+		return "question:" + ref["id"].(string), nil
+	})
+	registry.Register("User", func(ctx context.Context, ref Any) (interface{}, error) {
+		return "user:" + ref["id"].(string), nil
+	})
+
+	entities, err := registry.Resolve(context.Background(), []Any{
+		{"__typename": "User", "id": "1"},
+		{"__typename": "Question", "id": "2"},
+	})
+	if err != nil {
+		t.Fatalf("Resolve: %v", err)
+	}
+
+	want := []interface{}{"user:1", "question:2"}
+	for i, got := range entities {
+		if got != want[i] {
+			t.Errorf("entities[%d] = %v, want %v", i, got, want[i])
+		}
+	}
+}
+
+func TestRegistryResolveUnknownTypename(t *testing.T) {
+	registry := NewRegistry()
+	_, err := registry.Resolve(context.Background(), []Any{{"__typename": "Unknown"}})
+	if err == nil {
+		t.Fatal("Resolve: expected error for unregistered typename, got nil")
+	}
+}
@@ -0,0 +1,197 @@
+package resolver
+
+import (
+	"context"
+	"fmt"
+
+	"goask/core/adapter"
+	"goask/resolver/federation"
+)
+
+// sdl is the schema document served to the Apollo Router through
+// `_service`. It must be kept in sync with the resolver surface below as
+// that surface grows.
+const sdl = `
+schema {
+  query: Query
+  mutation: Mutation
+}
+
+directive @key(fields: _FieldSet!) repeatable on OBJECT | INTERFACE
+directive @external on FIELD_DEFINITION
+directive @requires(fields: _FieldSet!) on FIELD_DEFINITION
+directive @provides(fields: _FieldSet!) on FIELD_DEFINITION
+directive @auth(role: Role!) on FIELD_DEFINITION
+
+enum Role {
+  USER
+  ADMIN
+}
+
+type Query {
+  questions(first: Int, after: String, filter: QuestionsFilter): QuestionConnection!
+}
+
+type Mutation {
+  createQuestion(input: CreateQuestionInput!): Question! @auth(role: USER)
+  createAnswer(input: CreateAnswerInput!): Answer! @auth(role: USER)
+  updateQuestion(input: UpdateQuestionInput!): Question! @auth(role: USER)
+  deleteQuestion(id: ID!): Boolean! @auth(role: ADMIN)
+}
+
+input CreateQuestionInput {
+  title: String!
+  content: String!
+}
+
+input CreateAnswerInput {
+  questionId: ID!
+  content: String!
+}
+
+input UpdateQuestionInput {
+  id: ID!
+  title: String
+  content: String
+}
+
+input QuestionsFilter {
+  titleContains: String
+  authorId: ID
+  hasAnswers: Boolean
+}
+
+type QuestionConnection {
+  edges: [QuestionEdge!]!
+  pageInfo: PageInfo!
+}
+
+type QuestionEdge {
+  node: Question!
+  cursor: String!
+}
+
+type PageInfo {
+  hasNextPage: Boolean!
+  endCursor: String
+}
+
+type AnswerConnection {
+  edges: [AnswerEdge!]!
+  pageInfo: PageInfo!
+}
+
+type AnswerEdge {
+  node: Answer!
+  cursor: String!
+}
+
+type Question @key(fields: "id") {
+  id: ID!
+  title: String!
+  content: String!
+  answers: AnswerConnection!
+  author: User!
+}
+
+type Answer @key(fields: "id") {
+  id: ID!
+  content: String!
+  question: Question!
+  author: User!
+}
+
+type User @key(fields: "id") {
+  id: ID!
+  name: String!
+}
+`
+
+// Service is the `_Service` root field every federated subgraph must
+// expose.
+type Service struct{}
+
+// SDL returns the subgraph's schema document.
+func (Service) SDL() string {
+	return sdl
+}
+
+// Entity is the `_Entity` union returned by `_entities`. graphql-go
+// resolves unions through a `To<Type>` method per member, so Entity wraps
+// exactly one of the types this subgraph owns.
+type Entity struct {
+	question *Question
+	answer   *Answer
+	user     *User
+}
+
+func (e Entity) ToQuestion() (*Question, bool) { return e.question, e.question != nil }
+func (e Entity) ToAnswer() (*Answer, bool)     { return e.answer, e.answer != nil }
+func (e Entity) ToUser() (*User, bool)         { return e.user, e.user != nil }
+
+// NewEntityRegistry builds the federation.Registry that resolves
+// `_entities` for the types this subgraph owns, fetching each one through
+// data.
+func NewEntityRegistry(data adapter.Data) *federation.Registry {
+	registry := federation.NewRegistry()
+
+	registry.Register("Question", func(ctx context.Context, ref federation.Any) (interface{}, error) {
+		id, err := idFromRef(ref)
+		if err != nil {
+			return nil, err
+		}
+		question, err := data.QuestionByID(id)
+		if err != nil {
+			return nil, err
+		}
+		built := QuestionOne(question, data)
+		return Entity{question: &built}, nil
+	})
+
+	registry.Register("Answer", func(ctx context.Context, ref federation.Any) (interface{}, error) {
+		id, err := idFromRef(ref)
+		if err != nil {
+			return nil, err
+		}
+		answer, err := data.AnswerByID(id)
+		if err != nil {
+			return nil, err
+		}
+		built := AnswerOne(answer, data)
+		return Entity{answer: &built}, nil
+	})
+
+	registry.Register("User", func(ctx context.Context, ref federation.Any) (interface{}, error) {
+		id, err := idFromRef(ref)
+		if err != nil {
+			return nil, err
+		}
+		user, err := data.UserByID(id)
+		if err != nil {
+			return nil, err
+		}
+		built := UserOne(user)
+		return Entity{user: &built}, nil
+	})
+
+	return registry
+}
+
+// idFromRef extracts the `id` key representations are keyed by (per the
+// `@key(fields: "id")` directive on Question/Answer/User).
+func idFromRef(ref federation.Any) (int, error) {
+	switch v := ref["id"].(type) {
+	case int:
+		return v, nil
+	case float64:
+		return int(v), nil
+	case string:
+		var id int
+		if _, err := fmt.Sscanf(v, "%d", &id); err != nil {
+			return 0, fmt.Errorf("federation: invalid id %q in representation", v)
+		}
+		return id, nil
+	default:
+		return 0, fmt.Errorf("federation: representation missing id field")
+	}
+}
@@ -0,0 +1,122 @@
+package resolver
+
+import (
+	"context"
+
+	"goask/core/adapter"
+	"goask/resolver/directive"
+)
+
+// Mutation is the GraphQL Mutation root resolver.
+type Mutation struct {
+	data       adapter.Data
+	directives *directive.Registry
+}
+
+// NewMutation builds the Mutation root resolver.
+func NewMutation(data adapter.Data) *Mutation {
+	return &Mutation{data: data, directives: NewDirectiveRegistry()}
+}
+
+// CreateQuestionInput is the input for the createQuestion mutation.
+type CreateQuestionInput struct {
+	Title   string
+	Content string
+}
+
+// CreateQuestion creates a question and returns its resolver so that
+// clients can select fields on the result.
+func (m Mutation) CreateQuestion(ctx context.Context, args struct{ Input CreateQuestionInput }) (Question, error) {
+	caller, err := CallerFromContext(ctx)
+	if err != nil {
+		return Question{}, err
+	}
+	if err := requireRole(ctx, m.directives, RoleUser); err != nil {
+		return Question{}, err
+	}
+	if err := validateQuestionFields(args.Input.Title, args.Input.Content); err != nil {
+		return Question{}, err
+	}
+
+	question, err := m.data.InsertQuestion(args.Input.Title, args.Input.Content, caller.UserID)
+	if err != nil {
+		return Question{}, err
+	}
+	return QuestionOne(question, m.data), nil
+}
+
+// CreateAnswerInput is the input for the createAnswer mutation.
+type CreateAnswerInput struct {
+	QuestionID int32
+	Content    string
+}
+
+// CreateAnswer creates an answer to an existing question and returns its
+// resolver so that clients can select fields on the result.
+func (m Mutation) CreateAnswer(ctx context.Context, args struct{ Input CreateAnswerInput }) (Answer, error) {
+	caller, err := CallerFromContext(ctx)
+	if err != nil {
+		return Answer{}, err
+	}
+	if err := requireRole(ctx, m.directives, RoleUser); err != nil {
+		return Answer{}, err
+	}
+	if args.Input.Content == "" {
+		return Answer{}, newFieldError("content must not be empty", "content", "BAD_USER_INPUT")
+	}
+
+	answer, err := m.data.InsertAnswer(int(args.Input.QuestionID), args.Input.Content, caller.UserID)
+	if err != nil {
+		return Answer{}, toFieldError("questionId", err)
+	}
+	return AnswerOne(answer, m.data), nil
+}
+
+// UpdateQuestionInput is the input for the updateQuestion mutation. A nil
+// Title or Content leaves that field unchanged.
+type UpdateQuestionInput struct {
+	ID      int32
+	Title   *string
+	Content *string
+}
+
+// UpdateQuestion applies a partial update to an existing question.
+func (m Mutation) UpdateQuestion(ctx context.Context, args struct{ Input UpdateQuestionInput }) (Question, error) {
+	if err := requireRole(ctx, m.directives, RoleUser); err != nil {
+		return Question{}, err
+	}
+	if args.Input.Title != nil && *args.Input.Title == "" {
+		return Question{}, newFieldError("title must not be empty", "title", "BAD_USER_INPUT")
+	}
+	if args.Input.Content != nil && *args.Input.Content == "" {
+		return Question{}, newFieldError("content must not be empty", "content", "BAD_USER_INPUT")
+	}
+
+	question, err := m.data.UpdateQuestion(int(args.Input.ID), args.Input.Title, args.Input.Content)
+	if err != nil {
+		return Question{}, toFieldError("id", err)
+	}
+	return QuestionOne(question, m.data), nil
+}
+
+// DeleteQuestion deletes a question, returning true on success. Deletion
+// is restricted to admins.
+func (m Mutation) DeleteQuestion(ctx context.Context, args struct{ ID int32 }) (bool, error) {
+	if err := requireRole(ctx, m.directives, RoleAdmin); err != nil {
+		return false, err
+	}
+	if err := m.data.DeleteQuestion(int(args.ID)); err != nil {
+		return false, toFieldError("id", err)
+	}
+	return true, nil
+}
+
+func validateQuestionFields(title, content string) error {
+	if title == "" {
+		return newFieldError("title must not be empty", "title", "BAD_USER_INPUT")
+	}
+	if content == "" {
+		return newFieldError("content must not be empty", "content", "BAD_USER_INPUT")
+	}
+	return nil
+}
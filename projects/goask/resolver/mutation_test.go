@@ -0,0 +1,146 @@
+package resolver
+
+import (
+	"context"
+	"testing"
+)
+
+func TestCreateQuestionHappyPath(t *testing.T) {
+	data := &fakeData{}
+	mutation := NewMutation(data)
+	ctx := WithCaller(context.Background(), Caller{UserID: 1, Role: RoleUser})
+
+	got, err := mutation.CreateQuestion(ctx, struct{ Input CreateQuestionInput }{
+		Input: CreateQuestionInput{Title: "Why?", Content: "Because."},
+	})
+	if err != nil {
+		t.Fatalf("CreateQuestion: %v", err)
+	}
+	if got.Title() != "Why?" || got.Content() != "Because." {
+		t.Errorf("CreateQuestion() = %+v, want Title=Why? Content=Because.", got)
+	}
+}
+
+func TestCreateQuestionRequiresCaller(t *testing.T) {
+	mutation := NewMutation(&fakeData{})
+
+	_, err := mutation.CreateQuestion(context.Background(), struct{ Input CreateQuestionInput }{
+		Input: CreateQuestionInput{Title: "Why?", Content: "Because."},
+	})
+	if err != ErrNoCaller {
+		t.Fatalf("CreateQuestion() error = %v, want ErrNoCaller", err)
+	}
+}
+
+func TestCreateQuestionRejectsEmptyTitle(t *testing.T) {
+	mutation := NewMutation(&fakeData{})
+	ctx := WithCaller(context.Background(), Caller{UserID: 1, Role: RoleUser})
+
+	_, err := mutation.CreateQuestion(ctx, struct{ Input CreateQuestionInput }{
+		Input: CreateQuestionInput{Title: "", Content: "Because."},
+	})
+	fieldErr, ok := err.(*FieldError)
+	if !ok {
+		t.Fatalf("CreateQuestion() error = %v, want *FieldError", err)
+	}
+	if fieldErr.Field != "title" {
+		t.Errorf("FieldError.Field = %q, want %q", fieldErr.Field, "title")
+	}
+}
+
+func TestCreateAnswerHappyPath(t *testing.T) {
+	data := &fakeData{}
+	mutation := NewMutation(data)
+	ctx := WithCaller(context.Background(), Caller{UserID: 1, Role: RoleUser})
+
+	question, err := mutation.CreateQuestion(ctx, struct{ Input CreateQuestionInput }{
+		Input: CreateQuestionInput{Title: "Why?", Content: "Because."},
+	})
+	if err != nil {
+		t.Fatalf("CreateQuestion: %v", err)
+	}
+
+	answer, err := mutation.CreateAnswer(ctx, struct{ Input CreateAnswerInput }{
+		Input: CreateAnswerInput{QuestionID: question.ID(), Content: "42"},
+	})
+	if err != nil {
+		t.Fatalf("CreateAnswer: %v", err)
+	}
+	if answer.Content() != "42" {
+		t.Errorf("CreateAnswer().Content() = %q, want %q", answer.Content(), "42")
+	}
+}
+
+func TestCreateAnswerConflictOnMissingQuestion(t *testing.T) {
+	mutation := NewMutation(&fakeData{})
+	ctx := WithCaller(context.Background(), Caller{UserID: 1, Role: RoleUser})
+
+	_, err := mutation.CreateAnswer(ctx, struct{ Input CreateAnswerInput }{
+		Input: CreateAnswerInput{QuestionID: 999, Content: "42"},
+	})
+	fieldErr, ok := err.(*FieldError)
+	if !ok {
+		t.Fatalf("CreateAnswer() error = %v, want *FieldError", err)
+	}
+	if fieldErr.Code != "NOT_FOUND" {
+		t.Errorf("FieldError.Code = %q, want %q", fieldErr.Code, "NOT_FOUND")
+	}
+}
+
+func TestUpdateQuestionConflictOnMissingID(t *testing.T) {
+	mutation := NewMutation(&fakeData{})
+	ctx := WithCaller(context.Background(), Caller{UserID: 1, Role: RoleUser})
+
+	title := "New title"
+	_, err := mutation.UpdateQuestion(ctx, struct{ Input UpdateQuestionInput }{
+		Input: UpdateQuestionInput{ID: 999, Title: &title},
+	})
+	fieldErr, ok := err.(*FieldError)
+	if !ok {
+		t.Fatalf("UpdateQuestion() error = %v, want *FieldError", err)
+	}
+	if fieldErr.Code != "NOT_FOUND" {
+		t.Errorf("FieldError.Code = %q, want %q", fieldErr.Code, "NOT_FOUND")
+	}
+}
+
+func TestDeleteQuestionHappyPath(t *testing.T) {
+	data := &fakeData{}
+	mutation := NewMutation(data)
+	ctx := WithCaller(context.Background(), Caller{UserID: 1, Role: RoleUser})
+
+	created, err := mutation.CreateQuestion(ctx, struct{ Input CreateQuestionInput }{
+		Input: CreateQuestionInput{Title: "Why?", Content: "Because."},
+	})
+	if err != nil {
+		t.Fatalf("CreateQuestion: %v", err)
+	}
+
+	adminCtx := WithCaller(context.Background(), Caller{UserID: 2, Role: RoleAdmin})
+	ok, err := mutation.DeleteQuestion(adminCtx, struct{ ID int32 }{ID: created.ID()})
+	if err != nil || !ok {
+		t.Fatalf("DeleteQuestion() = (%v, %v), want (true, nil)", ok, err)
+	}
+}
+
+func TestDeleteQuestionRequiresAdminRole(t *testing.T) {
+	data := &fakeData{}
+	mutation := NewMutation(data)
+	ctx := WithCaller(context.Background(), Caller{UserID: 1, Role: RoleUser})
+
+	created, err := mutation.CreateQuestion(ctx, struct{ Input CreateQuestionInput }{
+		Input: CreateQuestionInput{Title: "Why?", Content: "Because."},
+	})
+	if err != nil {
+		t.Fatalf("CreateQuestion: %v", err)
+	}
+
+	_, err = mutation.DeleteQuestion(ctx, struct{ ID int32 }{ID: created.ID()})
+	fieldErr, ok := err.(*FieldError)
+	if !ok {
+		t.Fatalf("DeleteQuestion() error = %v, want *FieldError", err)
+	}
+	if fieldErr.Code != "FORBIDDEN" {
+		t.Errorf("FieldError.Code = %q, want %q", fieldErr.Code, "FORBIDDEN")
+	}
+}
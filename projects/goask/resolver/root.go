@@ -0,0 +1,89 @@
+package resolver
+
+import (
+	"context"
+
+	"goask/core/adapter"
+	"goask/resolver/federation"
+)
+
+// Root is the GraphQL Query root resolver.
+type Root struct {
+	data     adapter.Data
+	entities *federation.Registry
+}
+
+// NewRoot builds the Query root resolver, including federation support
+// for the entities this subgraph owns.
+func NewRoot(data adapter.Data) *Root {
+	return &Root{data: data, entities: NewEntityRegistry(data)}
+}
+
+// Service resolves the `_service` root field used by the Apollo Router to
+// fetch this subgraph's SDL.
+func (r Root) Service() Service {
+	return Service{}
+}
+
+// Entities resolves the `_entities` root field, dispatching each
+// representation to the reference resolver registered for its
+// `__typename`.
+func (r Root) Entities(ctx context.Context, args struct{ Representations []federation.Any }) ([]Entity, error) {
+	built, err := r.entities.Resolve(ctx, args.Representations)
+	if err != nil {
+		return nil, err
+	}
+	entities := make([]Entity, len(built))
+	for i, e := range built {
+		entities[i] = e.(Entity)
+	}
+	return entities, nil
+}
+
+// defaultQuestionsPageSize is used when the Questions query omits `first`.
+const defaultQuestionsPageSize = 20
+
+// QuestionsFilterInput narrows the Questions root query.
+type QuestionsFilterInput struct {
+	TitleContains *string
+	AuthorID      *int32
+	HasAnswers    *bool
+}
+
+// Questions resolves the paginated, filterable list of questions.
+func (r Root) Questions(ctx context.Context, args struct {
+	First  *int32
+	After  *string
+	Filter *QuestionsFilterInput
+}) (QuestionConnection, error) {
+	limit := defaultQuestionsPageSize
+	if args.First != nil {
+		if *args.First <= 0 {
+			return QuestionConnection{}, newFieldError("first must be positive", "first", "BAD_USER_INPUT")
+		}
+		limit = int(*args.First)
+	}
+
+	var after string
+	if args.After != nil {
+		after = *args.After
+	}
+
+	var filter adapter.QuestionFilter
+	if args.Filter != nil {
+		if args.Filter.TitleContains != nil {
+			filter.TitleContains = *args.Filter.TitleContains
+		}
+		if args.Filter.AuthorID != nil {
+			authorID := int(*args.Filter.AuthorID)
+			filter.AuthorID = &authorID
+		}
+		filter.HasAnswers = args.Filter.HasAnswers
+	}
+
+	page, err := r.data.QuestionsPage(filter, after, limit)
+	if err != nil {
+		return QuestionConnection{}, err
+	}
+	return newQuestionConnection(page, r.data), nil
+}
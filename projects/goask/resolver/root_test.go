@@ -0,0 +1,45 @@
+package resolver
+
+import (
+	"context"
+	"testing"
+
+	"goask/resolver/federation"
+)
+
+func TestRootServiceReturnsSDL(t *testing.T) {
+	root := NewRoot(&fakeData{})
+	if got := root.Service().SDL(); got == "" {
+		t.Fatal("Service().SDL() returned empty string")
+	}
+}
+
+func TestRootEntitiesDispatchesByTypename(t *testing.T) {
+	root := NewRoot(&fakeData{})
+
+	entities, err := root.Entities(context.Background(), struct {
+		Representations []federation.Any
+	}{
+		Representations: []federation.Any{
+			{"__typename": "Question", "id": "1"},
+			{"__typename": "Answer", "id": "2"},
+			{"__typename": "User", "id": "3"},
+		},
+	})
+	if err != nil {
+		t.Fatalf("Entities: %v", err)
+	}
+	if len(entities) != 3 {
+		t.Fatalf("len(entities) = %d, want 3", len(entities))
+	}
+
+	if q, ok := entities[0].ToQuestion(); !ok || q.ID() != 1 {
+		t.Errorf("entities[0] = %+v, want Question{ID: 1}", entities[0])
+	}
+	if a, ok := entities[1].ToAnswer(); !ok || a.ID() != 2 {
+		t.Errorf("entities[1] = %+v, want Answer{ID: 2}", entities[1])
+	}
+	if u, ok := entities[2].ToUser(); !ok || u.ID() != 3 {
+		t.Errorf("entities[2] = %+v, want User{ID: 3}", entities[2])
+	}
+}
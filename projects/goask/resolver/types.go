@@ -1,8 +1,11 @@
 package resolver
 
 import (
+	"context"
+
 	"goask/core/adapter"
 	"goask/core/entity"
+	"goask/resolver/dataloader"
 )
 
 // Question is the GraphQL resolver for Question type.
@@ -23,13 +26,17 @@ func (q Question) Content() string {
 	return string(q.entity.Content)
 }
 
-func (q Question) Answers() []Answer {
-	answers := q.data.AnswersOfQuestion(q.entity.ID)
-	return AnswerAll(answers, q.data)
+func (q Question) Answers(ctx context.Context) (AnswerConnection, error) {
+	answers, err := dataloader.FromContext(ctx).AnswersByQuestionID.Load(ctx, q.entity.ID)
+	if err != nil {
+		return AnswerConnection{}, err
+	}
+	return newAnswerConnection(answers, q.data), nil
 }
 
-func (q Question) Author() User {
-	return User{}
+func (q Question) Author(ctx context.Context) (User, error) {
+	user, err := dataloader.FromContext(ctx).UserByID.Load(ctx, q.entity.AuthorID)
+	return UserOne(user), err
 }
 
 // Answer is the GraphQL resolver for Answer type.
@@ -46,13 +53,14 @@ func (a Answer) Content() string {
 	return a.entity.Content
 }
 
-func (a Answer) Question() (Question, error) {
-	question, err := a.data.QuestionByID(a.entity.QuestionID)
+func (a Answer) Question(ctx context.Context) (Question, error) {
+	question, err := dataloader.FromContext(ctx).QuestionByID.Load(ctx, a.entity.QuestionID)
 	return QuestionOne(question, a.data), err
 }
 
-func (a Answer) Author() User {
-	return User{}
+func (a Answer) Author(ctx context.Context) (User, error) {
+	user, err := dataloader.FromContext(ctx).UserByID.Load(ctx, a.entity.AuthorID)
+	return UserOne(user), err
 }
 
 func QuestionOne(question entity.Question, data adapter.Data) Question {
@@ -74,14 +82,6 @@ func AnswerOne(a entity.Answer, data adapter.Data) Answer {
 	return Answer{entity: a, data: data}
 }
 
-func AnswerAll(as []entity.Answer, data adapter.Data) []Answer {
-	answers := make([]Answer, len(as))
-	for i, a := range as {
-		answers[i] = AnswerOne(a, data)
-	}
-	return answers
-}
-
 type User struct {
 	entity entity.User
 }
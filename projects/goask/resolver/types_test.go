@@ -0,0 +1,215 @@
+package resolver
+
+import (
+	"context"
+	"sort"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"goask/core/adapter"
+	"goask/core/entity"
+	"goask/resolver/dataloader"
+)
+
+// fakeData is a minimal in-memory adapter.Data used by resolver tests in
+// place of a real database.
+type fakeData struct {
+	answersCalls int32
+	answers      map[int][]entity.Answer
+
+	mu        sync.Mutex
+	questions map[int]entity.Question
+	nextID    int
+}
+
+func (f *fakeData) InsertQuestion(title, content string, authorID int) (entity.Question, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if f.questions == nil {
+		f.questions = make(map[int]entity.Question)
+	}
+	f.nextID++
+	question := entity.Question{ID: f.nextID, Title: title, Content: content, AuthorID: authorID}
+	f.questions[question.ID] = question
+	return question, nil
+}
+
+func (f *fakeData) InsertAnswer(questionID int, content string, authorID int) (entity.Answer, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if _, ok := f.questions[questionID]; !ok {
+		return entity.Answer{}, adapter.ErrNotFound
+	}
+	f.nextID++
+	return entity.Answer{ID: f.nextID, Content: content, QuestionID: questionID, AuthorID: authorID}, nil
+}
+
+func (f *fakeData) UpdateQuestion(id int, title, content *string) (entity.Question, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	question, ok := f.questions[id]
+	if !ok {
+		return entity.Question{}, adapter.ErrNotFound
+	}
+	if title != nil {
+		question.Title = *title
+	}
+	if content != nil {
+		question.Content = *content
+	}
+	f.questions[id] = question
+	return question, nil
+}
+
+func (f *fakeData) DeleteQuestion(id int) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if _, ok := f.questions[id]; !ok {
+		return adapter.ErrNotFound
+	}
+	delete(f.questions, id)
+	return nil
+}
+
+func (f *fakeData) AnswersOfQuestion(questionIDs []int) []entity.Answer {
+	atomic.AddInt32(&f.answersCalls, 1)
+	var all []entity.Answer
+	for _, id := range questionIDs {
+		all = append(all, f.answers[id]...)
+	}
+	return all
+}
+
+func (f *fakeData) QuestionByID(id int) (entity.Question, error) {
+	return entity.Question{ID: id}, nil
+}
+
+func (f *fakeData) AnswerByID(id int) (entity.Answer, error) {
+	return entity.Answer{ID: id}, nil
+}
+
+func (f *fakeData) UserByID(id int) (entity.User, error) {
+	return entity.User{ID: id}, nil
+}
+
+func (f *fakeData) QuestionsPage(filter adapter.QuestionFilter, cursorStr string, limit int) (adapter.QuestionPage, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	afterID, afterCreatedAt, err := adapter.DecodeCursor(cursorStr)
+	if err != nil {
+		return adapter.QuestionPage{}, err
+	}
+
+	var matched []entity.Question
+	for _, q := range f.questions {
+		if filter.TitleContains != "" && !strings.Contains(q.Title, filter.TitleContains) {
+			continue
+		}
+		if filter.AuthorID != nil && q.AuthorID != *filter.AuthorID {
+			continue
+		}
+		if filter.HasAnswers != nil && (len(f.answers[q.ID]) > 0) != *filter.HasAnswers {
+			continue
+		}
+		matched = append(matched, q)
+	}
+	sort.Slice(matched, func(i, j int) bool {
+		if matched[i].CreatedAt.Equal(matched[j].CreatedAt) {
+			return matched[i].ID < matched[j].ID
+		}
+		return matched[i].CreatedAt.Before(matched[j].CreatedAt)
+	})
+
+	var after []entity.Question
+	for _, q := range matched {
+		if cursorStr == "" || q.CreatedAt.After(afterCreatedAt) || (q.CreatedAt.Equal(afterCreatedAt) && q.ID > afterID) {
+			after = append(after, q)
+		}
+	}
+
+	hasNextPage := len(after) > limit
+	if hasNextPage {
+		after = after[:limit]
+	}
+	return adapter.QuestionPage{Questions: after, HasNextPage: hasNextPage}, nil
+}
+
+// setCreatedAt backdates a question's CreatedAt for deterministic
+// pagination tests; InsertQuestion itself leaves it at storage's default.
+func (f *fakeData) setCreatedAt(id int, createdAt time.Time) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	q := f.questions[id]
+	q.CreatedAt = createdAt
+	f.questions[id] = q
+}
+
+func TestQuestionAnswersBatchesAcrossQuestions(t *testing.T) {
+	data := &fakeData{
+		answers: map[int][]entity.Answer{
+			1: {{ID: 1, QuestionID: 1}},
+			2: {{ID: 2, QuestionID: 2}},
+			3: {{ID: 3, QuestionID: 3}},
+		},
+	}
+	ctx := dataloader.WithLoaders(context.Background(), dataloader.NewLoaders(data))
+
+	questions := QuestionAll([]entity.Question{{ID: 1}, {ID: 2}, {ID: 3}}, data)
+
+	var wg sync.WaitGroup
+	for _, q := range questions {
+		wg.Add(1)
+		go func(q Question) {
+			defer wg.Done()
+			if _, err := q.Answers(ctx); err != nil {
+				t.Errorf("Answers: %v", err)
+			}
+		}(q)
+	}
+	wg.Wait()
+
+	// dataloader batches concurrent keys into a single underlying data
+	// call per batch window, so this must not issue one call per question.
+	if got := atomic.LoadInt32(&data.answersCalls); got != 1 {
+		t.Fatalf("AnswersOfQuestion called %d times, want 1", got)
+	}
+}
+
+func TestQuestionAnswersReturnsConnection(t *testing.T) {
+	data := &fakeData{
+		answers: map[int][]entity.Answer{
+			1: {{ID: 1, QuestionID: 1}, {ID: 2, QuestionID: 1}},
+		},
+	}
+	ctx := dataloader.WithLoaders(context.Background(), dataloader.NewLoaders(data))
+
+	question := QuestionOne(entity.Question{ID: 1}, data)
+	connection, err := question.Answers(ctx)
+	if err != nil {
+		t.Fatalf("Answers: %v", err)
+	}
+	if len(connection.Edges()) != 2 {
+		t.Fatalf("len(Edges()) = %d, want 2", len(connection.Edges()))
+	}
+	if connection.PageInfo().EndCursor() == nil {
+		t.Error("PageInfo().EndCursor() = nil, want non-nil for a non-empty connection")
+	}
+}
+
+func TestQuestionAuthorResolvesRealUser(t *testing.T) {
+	data := &fakeData{}
+	ctx := dataloader.WithLoaders(context.Background(), dataloader.NewLoaders(data))
+
+	question := QuestionOne(entity.Question{ID: 1, AuthorID: 42}, data)
+	author, err := question.Author(ctx)
+	if err != nil {
+		t.Fatalf("Author: %v", err)
+	}
+	if author.ID() != 42 {
+		t.Errorf("Author().ID() = %d, want 42", author.ID())
+	}
+}